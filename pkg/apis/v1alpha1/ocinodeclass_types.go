@@ -0,0 +1,96 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// CapacityTypePreemptible and CapacityTypeReserved are the OCI-specific capacity types,
+	// alongside v1.CapacityTypeOnDemand which karpenter-core already defines.
+	CapacityTypePreemptible = "preemptible"
+	CapacityTypeReserved    = "reserved"
+)
+
+// LabelCapacityReservationID is set on offerings/requirements for a reserved-capacity
+// instance type, carrying the OCID of the capacity reservation the scheduler selected.
+const LabelCapacityReservationID = "karpenter.k8s.oracle/capacity-reservation-id"
+
+const (
+	// LabelDedicatedVmHostID carries the OCID of the Dedicated VM Host an offering was
+	// built from.
+	LabelDedicatedVmHostID = "karpenter.k8s.oracle/dedicated-vm-host-id"
+	// LabelFaultDomain carries the fault domain of the Dedicated VM Host an offering was
+	// built from.
+	LabelFaultDomain = "karpenter.k8s.oracle/fault-domain"
+)
+
+// DedicatedVmHostSelectorTerm selects OCI Dedicated VM Hosts by id, display name, or
+// freeform tags. A term matches a host if any one of its non-empty fields matches.
+type DedicatedVmHostSelectorTerm struct {
+	// Id is the OCID of a specific Dedicated VM Host.
+	// +optional
+	Id string `json:"id,omitempty"`
+	// Name is the display name of a Dedicated VM Host.
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Tags is a set of freeform tags a Dedicated VM Host must carry.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// CapacityReservationSelectorTerm selects OCI Compute Capacity Reservations by id, display
+// name, or freeform tags. A term matches a reservation if any one of its non-empty fields
+// matches.
+type CapacityReservationSelectorTerm struct {
+	// Id is the OCID of a specific capacity reservation.
+	// +optional
+	Id string `json:"id,omitempty"`
+	// Name is the display name of a capacity reservation.
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Tags is a set of freeform tags a capacity reservation must carry.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// OciNodeClassSpec configures how the provider discovers instance types and capacity for
+// NodeClaims referencing this NodeClass.
+type OciNodeClassSpec struct {
+	// CapacityReservationSelectorTerms selects the OCI Compute Capacity Reservations this
+	// NodeClass's instance types may schedule onto as the "reserved" capacity type.
+	// +optional
+	CapacityReservationSelectorTerms []CapacityReservationSelectorTerm `json:"capacityReservationSelectorTerms,omitempty"`
+	// DedicatedVmHostSelectorTerms selects the Dedicated VM Hosts this NodeClass's instance
+	// types are scoped to. A shape with no selected host running it is not schedulable.
+	// +optional
+	DedicatedVmHostSelectorTerms []DedicatedVmHostSelectorTerm `json:"dedicatedVmHostSelectorTerms,omitempty"`
+}
+
+// OciNodeClassStatus reports the resolved state of an OciNodeClass.
+type OciNodeClassStatus struct{}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// OciNodeClass is the Schema for the OciNodeClass API.
+type OciNodeClass struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OciNodeClassSpec   `json:"spec,omitempty"`
+	Status OciNodeClassStatus `json:"status,omitempty"`
+}
@@ -0,0 +1,46 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+)
+
+// UnavailableOfferings tracks (instanceType, zone, capacityType) tuples that recently
+// returned an insufficient-capacity error from the OCI API, so the provider stops
+// offering them until the entry expires.
+type UnavailableOfferings struct {
+	cache *cache.Cache
+}
+
+func NewUnavailableOfferings() *UnavailableOfferings {
+	return &UnavailableOfferings{cache: cache.New(3*time.Minute, time.Minute)}
+}
+
+func (u *UnavailableOfferings) IsUnavailable(instanceType, zone, capacityType string) bool {
+	_, found := u.cache.Get(unavailableOfferingKey(instanceType, zone, capacityType))
+	return found
+}
+
+func (u *UnavailableOfferings) MarkUnavailable(instanceType, zone, capacityType string) {
+	u.cache.SetDefault(unavailableOfferingKey(instanceType, zone, capacityType), struct{}{})
+}
+
+func unavailableOfferingKey(instanceType, zone, capacityType string) string {
+	return fmt.Sprintf("%s:%s:%s", instanceType, zone, capacityType)
+}
@@ -0,0 +1,36 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/core"
+)
+
+// ComputeClient is the subset of the OCI Compute service client the instance type
+// provider depends on.
+type ComputeClient interface {
+	ListShapes(ctx context.Context, request core.ListShapesRequest) (core.ListShapesResponse, error)
+	ListDedicatedVmHosts(ctx context.Context, request core.ListDedicatedVmHostsRequest) (core.ListDedicatedVmHostsResponse, error)
+	ListDedicatedVmHostShapes(ctx context.Context, request core.ListDedicatedVmHostShapesRequest) (core.ListDedicatedVmHostShapesResponse, error)
+}
+
+// CapacityReservationClient is the subset of the OCI Compute service client used to
+// discover Capacity Reservations and the instance shapes reserved within them.
+type CapacityReservationClient interface {
+	ListComputeCapacityReservations(ctx context.Context, request core.ListComputeCapacityReservationsRequest) (core.ListComputeCapacityReservationsResponse, error)
+	ListComputeCapacityReservationInstanceShapes(ctx context.Context, request core.ListComputeCapacityReservationInstanceShapesRequest) (core.ListComputeCapacityReservationInstanceShapesResponse, error)
+}
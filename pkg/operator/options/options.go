@@ -0,0 +1,51 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package options
+
+import (
+	"context"
+	"flag"
+)
+
+type optionsKey struct{}
+
+// Options holds CLI-flag-derived configuration, threaded through context.Context rather
+// than passed explicitly through every provider method.
+type Options struct {
+	CompartmentId            string
+	AvailableDomains         []string
+	PreemptibleShapes        string
+	PreemptibleExcludeShapes string
+	FlexCpuMemRatios         string
+	FlexCpuConstrainList     string
+	// InstanceTypeCacheDir, if non-empty, is where the instance type provider persists a
+	// warm-start snapshot of the instance type cache across restarts.
+	InstanceTypeCacheDir string
+}
+
+// AddFlags registers the --instance-type-cache-dir flag on fs. The other Options fields
+// have no flag registered here.
+func (o *Options) AddFlags(fs *flag.FlagSet) {
+	fs.StringVar(&o.InstanceTypeCacheDir, "instance-type-cache-dir", "",
+		"Directory used to persist a warm-start snapshot of the instance type cache across restarts. Empty disables disk persistence.")
+}
+
+func ToContext(ctx context.Context, opts *Options) context.Context {
+	return context.WithValue(ctx, optionsKey{}, opts)
+}
+
+func FromContext(ctx context.Context) *Options {
+	return ctx.Value(optionsKey{}).(*Options)
+}
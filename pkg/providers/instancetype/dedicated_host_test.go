@@ -0,0 +1,67 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancetype
+
+import (
+	"testing"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/zoom/karpenter-oci/pkg/apis/v1alpha1"
+)
+
+func TestMatchesDedicatedHostSelector(t *testing.T) {
+	host := core.DedicatedVmHostSummary{
+		Id:           common.String("ocid1.dedicatedvmhost.oc1..aaa"),
+		DisplayName:  common.String("dvh-a"),
+		FreeformTags: map[string]string{"pool": "gpu"},
+	}
+
+	tests := []struct {
+		name  string
+		terms []v1alpha1.DedicatedVmHostSelectorTerm
+		want  bool
+	}{
+		{"matches by id", []v1alpha1.DedicatedVmHostSelectorTerm{{Id: "ocid1.dedicatedvmhost.oc1..aaa"}}, true},
+		{"matches by name", []v1alpha1.DedicatedVmHostSelectorTerm{{Name: "dvh-a"}}, true},
+		{"matches by tag", []v1alpha1.DedicatedVmHostSelectorTerm{{Tags: map[string]string{"pool": "gpu"}}}, true},
+		{"no match on different id", []v1alpha1.DedicatedVmHostSelectorTerm{{Id: "ocid1.dedicatedvmhost.oc1..bbb"}}, false},
+		{"no match on different tag value", []v1alpha1.DedicatedVmHostSelectorTerm{{Tags: map[string]string{"pool": "cpu"}}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchesDedicatedHostSelector(host, tt.terms); got != tt.want {
+				t.Errorf("matchesDedicatedHostSelector() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestDedicatedHostsCacheKeyScopedBySelector guards against the bug where two NodeClasses
+// with different dedicatedVmHostSelectorTerms shared one Provider's cache entry and could
+// read each other's hosts before TTL expiry.
+func TestDedicatedHostsCacheKeyScopedBySelector(t *testing.T) {
+	a := []v1alpha1.DedicatedVmHostSelectorTerm{{Id: "ocid1.dedicatedvmhost.oc1..aaa"}}
+	b := []v1alpha1.DedicatedVmHostSelectorTerm{{Id: "ocid1.dedicatedvmhost.oc1..bbb"}}
+
+	keyA := dedicatedHostsCacheKey(a)
+	keyB := dedicatedHostsCacheKey(b)
+	if keyA == keyB {
+		t.Fatal("different selector terms must not produce the same cache key")
+	}
+	if keyA != dedicatedHostsCacheKey(a) {
+		t.Fatal("the same selector terms must produce a stable cache key")
+	}
+}
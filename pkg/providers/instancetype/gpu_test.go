@@ -0,0 +1,116 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancetype
+
+import (
+	"testing"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/zoom/karpenter-oci/pkg/providers/internalmodel"
+)
+
+func TestShapeLabels(t *testing.T) {
+	tests := []struct {
+		name  string
+		shape *internalmodel.WrapShape
+		want  map[string]string
+	}{
+		{
+			name:  "cpu shape has no gpu/rdma/nvme labels",
+			shape: &internalmodel.WrapShape{},
+			want:  map[string]string{},
+		},
+		{
+			name:  "gpu shape",
+			shape: &internalmodel.WrapShape{GpuCount: 8, GpuModel: "NVIDIA A100"},
+			want:  map[string]string{LabelGPUCount: "8", LabelGPUModel: "NVIDIA A100"},
+		},
+		{
+			name:  "local nvme shape",
+			shape: &internalmodel.WrapShape{LocalNvmeGBs: 7680},
+			want:  map[string]string{LabelLocalNvmeGB: "7680"},
+		},
+		{
+			name:  "rdma eligible shape",
+			shape: &internalmodel.WrapShape{RdmaEligible: true},
+			want:  map[string]string{LabelRDMA: "true"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := shapeLabels(tt.shape)
+			if len(got) != len(tt.want) {
+				t.Fatalf("shapeLabels() = %v, want %v", got, tt.want)
+			}
+			for k, v := range tt.want {
+				if got[k] != v {
+					t.Errorf("shapeLabels()[%q] = %q, want %q", k, got[k], v)
+				}
+			}
+		})
+	}
+}
+
+func TestShapeExtendedResourcesNonGPU(t *testing.T) {
+	capacity, overhead := shapeExtendedResources(&internalmodel.WrapShape{})
+	if capacity != nil || overhead != nil {
+		t.Errorf("shapeExtendedResources() on a non-GPU shape = (%v, %v), want (nil, nil)", capacity, overhead)
+	}
+}
+
+func TestShapeExtendedResourcesGPU(t *testing.T) {
+	shape := &internalmodel.WrapShape{GpuCount: 2}
+	capacity, overhead := shapeExtendedResources(shape)
+
+	gpuQty, ok := capacity[ResourceNvidiaGPU]
+	if !ok {
+		t.Fatal("capacity is missing the nvidia.com/gpu resource")
+	}
+	if gpuQty.Value() != 2 {
+		t.Errorf("capacity[nvidia.com/gpu] = %d, want 2", gpuQty.Value())
+	}
+
+	for name, perGPU := range gpuDaemonOverheadPerGPU {
+		got, ok := overhead[name]
+		if !ok {
+			t.Fatalf("overhead is missing %s", name)
+		}
+		want := perGPU.MilliValue() * shape.GpuCount
+		if got.MilliValue() != want {
+			t.Errorf("overhead[%s] = %dm, want %dm (2x the per-GPU overhead)", name, got.MilliValue(), want)
+		}
+	}
+}
+
+func TestLocalNvmeGBsAndRdmaEligible(t *testing.T) {
+	if !isRdmaEligible("BM.GPU4.8") {
+		t.Error("BM.GPU4.8 should be RDMA eligible")
+	}
+	if !isRdmaEligible("BM.HPC2.36") {
+		t.Error("BM.HPC2.36 should be RDMA eligible")
+	}
+	if isRdmaEligible("VM.Standard.E4.Flex") {
+		t.Error("VM.Standard.E4.Flex should not be RDMA eligible")
+	}
+
+	if got := localNvmeGBs(core.Shape{}); got != 0 {
+		t.Errorf("localNvmeGBs() on a shape with no local disks = %d, want 0", got)
+	}
+	withDisks := core.Shape{LocalDisksTotalSizeInGBs: common.Float32(7680)}
+	if got := localNvmeGBs(withDisks); got != 7680 {
+		t.Errorf("localNvmeGBs() = %d, want 7680", got)
+	}
+}
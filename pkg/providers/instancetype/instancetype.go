@@ -16,10 +16,13 @@ package instancetype
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/core"
@@ -34,7 +37,9 @@ import (
 	"github.com/zoom/karpenter-oci/pkg/providers/pricing"
 	"github.com/zoom/karpenter-oci/pkg/utils"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 	v1 "sigs.k8s.io/karpenter/pkg/apis/v1"
 	"sigs.k8s.io/karpenter/pkg/cloudprovider"
 	"sigs.k8s.io/karpenter/pkg/scheduling"
@@ -42,21 +47,85 @@ import (
 
 const (
 	InstanceTypesCacheKey = "types"
+	// ReservationsCacheKey and DedicatedHostsCacheKey are prefixes, not full cache keys:
+	// the NodeClass's selector terms are hashed onto the end of each (see
+	// reservationsCacheKey/dedicatedHostsCacheKey) so two NodeClasses pointed at different
+	// reservations or hosts never share a cache entry.
+	ReservationsCacheKey   = "reservations"
+	DedicatedHostsCacheKey = "dedicated-hosts"
 )
 
-var supportInstanceTypes = []string{v1.CapacityTypeOnDemand, v1alpha1.CapacityTypePreemptible}
+// instanceTypeCacheStale reports whether the instance type cache is currently serving a
+// disk-warm-started snapshot (1) that hasn't yet been replaced by a live ListInstanceType
+// refresh, or live data (0). This makes Snapshot.Stale (see snapshot.go) observable:
+// previously nothing read it, so there was no way to tell a stale warm-started cache from
+// a freshly refreshed one.
+var instanceTypeCacheStale = prometheus.NewGauge(prometheus.GaugeOpts{
+	Namespace: "karpenter_oci",
+	Subsystem: "cloudprovider",
+	Name:      "instance_type_cache_stale",
+	Help:      "1 if the instance type cache is serving a disk-warm-started snapshot that hasn't been refreshed from the OCI API yet, 0 otherwise.",
+})
+
+// preemptibleDiscountRatio records the ratio of the preemptible price CreateOfferings
+// obtained from pricing.Provider.PreemptiblePrice to the on-demand price, per shape and
+// zone, so operators can see the actual discount instead of assuming the flat 50% this
+// replaced.
+var preemptibleDiscountRatio = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: "karpenter_oci",
+	Name:      "preemptible_discount_ratio",
+	Help:      "Ratio of preemptible price to on-demand price for an instance type in a zone.",
+}, []string{instanceTypeLabel, zoneLabel})
+
+// dedicatedHostLabel is the Prometheus label key for dedicatedHostCapacityAvailable.
+const dedicatedHostLabel = "dedicated_host_id"
+
+// dedicatedHostCapacityAvailable records the remaining OCPUs on a Dedicated VM Host
+// selected by a NodeClass's dedicatedVmHostSelectorTerms.
+var dedicatedHostCapacityAvailable = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: "karpenter_oci",
+	Name:      "dedicated_host_capacity_available",
+	Help:      "Remaining OCPUs on a Dedicated VM Host selected by a NodeClass.",
+}, []string{dedicatedHostLabel})
+
+func init() {
+	prometheus.MustRegister(instanceTypeCacheStale, preemptibleDiscountRatio, dedicatedHostCapacityAvailable)
+}
+
+var supportInstanceTypes = []string{v1.CapacityTypeOnDemand, v1alpha1.CapacityTypePreemptible, v1alpha1.CapacityTypeReserved}
 
 type Provider struct {
 	region               string
 	compClient           api.ComputeClient
+	resvClient           api.CapacityReservationClient
 	mu                   sync.Mutex
 	cache                *cache.Cache
 	unavailableOfferings *ocicache.UnavailableOfferings
 	priceProvider        pricing.Provider
+	snapshotStore        SnapshotStore
+	// cacheStale is true from a disk warm-start until the first live ListInstanceType
+	// refresh succeeds, so operators and callers can tell a stale snapshot from fresh data.
+	cacheStale atomic.Bool
 }
 
-func NewProvider(region string, compClient api.ComputeClient, cache *cache.Cache, unavailableOfferings *ocicache.UnavailableOfferings, priceProvide pricing.Provider) *Provider {
-	return &Provider{region: region, compClient: compClient, cache: cache, unavailableOfferings: unavailableOfferings, priceProvider: priceProvide}
+// NewProvider wires up the instance type provider. snapshotStore may be nil, in which
+// case the cache is always cold-started from the OCI API with no disk warm-start.
+func NewProvider(region string, compClient api.ComputeClient, resvClient api.CapacityReservationClient, cache *cache.Cache, unavailableOfferings *ocicache.UnavailableOfferings, priceProvide pricing.Provider, snapshotStore SnapshotStore) *Provider {
+	p := &Provider{region: region, compClient: compClient, resvClient: resvClient, cache: cache, unavailableOfferings: unavailableOfferings, priceProvider: priceProvide, snapshotStore: snapshotStore}
+	if snapshotStore != nil {
+		if snap, ok := snapshotStore.Load(region); ok {
+			// Warm the cache so a crash-looping controller doesn't hammer ListShapes while
+			// waiting for the first live refresh; ListInstanceType overwrites this with
+			// live data, on its own TTL, the first time it succeeds.
+			p.cache.SetDefault(InstanceTypesCacheKey, snap.Shapes)
+			p.cacheStale.Store(snap.Stale)
+			if snap.Stale {
+				instanceTypeCacheStale.Set(1)
+				log.Log.WithName("instancetype").Info("warm-started instance type cache from disk snapshot; marking stale until first live refresh", "region", region)
+			}
+		}
+	}
+	return p
 }
 
 func (p *Provider) List(ctx context.Context, nodeClass *v1alpha1.OciNodeClass) ([]*cloudprovider.InstanceType, error) {
@@ -65,28 +134,267 @@ func (p *Provider) List(ctx context.Context, nodeClass *v1alpha1.OciNodeClass) (
 	if err != nil {
 		return nil, err
 	}
+	reservations, err := p.ListCapacityReservations(ctx, nodeClass)
+	if err != nil {
+		return nil, err
+	}
+	dedicatedHosts, err := p.ListDedicatedVmHosts(ctx, nodeClass)
+	if err != nil {
+		return nil, err
+	}
 	instanceTypes := make([]*cloudprovider.InstanceType, 0)
 	for _, wrapped := range wrapShapes {
-		instanceTypes = append(instanceTypes, NewInstanceType(ctx, wrapped, nodeClass, p.region, wrapped.AvailableDomains, p.CreateOfferings(ctx, wrapped, sets.New(wrapped.AvailableDomains...))))
+		hosts := dedicatedHosts[*wrapped.Shape.Shape]
+		if len(nodeClass.Spec.DedicatedVmHostSelectorTerms) > 0 && len(hosts) == 0 {
+			// This NodeClass is DVH-scoped and no selected host runs this shape, so it
+			// isn't schedulable at all rather than silently falling back to shared capacity.
+			continue
+		}
+		instanceTypes = append(instanceTypes, NewInstanceType(ctx, wrapped, nodeClass, p.region, wrapped.AvailableDomains, p.CreateOfferings(ctx, wrapped, sets.New(wrapped.AvailableDomains...), reservations, hosts)))
 	}
 	return instanceTypes, nil
 
 }
 
-func (p *Provider) CreateOfferings(ctx context.Context, shape *internalmodel.WrapShape, zones sets.Set[string]) []*cloudprovider.Offering {
+// ListDedicatedVmHosts returns, for each shape name, the Dedicated VM Hosts selected by
+// dedicatedVmHostSelectorTerms that currently run that shape. A NodeClass with no
+// selector terms isn't DVH-scoped, so nil is returned and every shape schedules onto
+// shared capacity as before.
+func (p *Provider) ListDedicatedVmHosts(ctx context.Context, nodeClass *v1alpha1.OciNodeClass) (map[string][]internalmodel.DedicatedVmHost, error) {
+	if len(nodeClass.Spec.DedicatedVmHostSelectorTerms) == 0 {
+		return nil, nil
+	}
+	cacheKey := dedicatedHostsCacheKey(nodeClass.Spec.DedicatedVmHostSelectorTerms)
+	if cached, ok := p.cache.Get(cacheKey); ok {
+		return cached.(map[string][]internalmodel.DedicatedVmHost), nil
+	}
+
+	hostsByShape := make(map[string][]internalmodel.DedicatedVmHost)
+	for _, availableDomain := range options.FromContext(ctx).AvailableDomains {
+		hosts := make([]core.DedicatedVmHostSummary, 0)
+		nextPage := ""
+		for {
+			resp, err := p.compClient.ListDedicatedVmHosts(ctx, core.ListDedicatedVmHostsRequest{
+				CompartmentId:      common.String(options.FromContext(ctx).CompartmentId),
+				AvailabilityDomain: common.String(availableDomain),
+				Page:               common.String(nextPage),
+			})
+			if err != nil {
+				return nil, err
+			}
+			hosts = append(hosts, resp.Items...)
+			if resp.OpcNextPage == nil {
+				break
+			}
+			nextPage = *resp.OpcNextPage
+		}
+		ad := strings.Split(availableDomain, ":")[1]
+
+		// ListDedicatedVmHostShapes is scoped to CompartmentId/AvailabilityDomain, not to an
+		// individual host, so its response is identical for every host in this AD; fetch it
+		// once per AD instead of once per matched host to avoid redundant API traffic.
+		shapesResp, err := p.compClient.ListDedicatedVmHostShapes(ctx, core.ListDedicatedVmHostShapesRequest{
+			CompartmentId:      common.String(options.FromContext(ctx).CompartmentId),
+			AvailabilityDomain: common.String(availableDomain),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, host := range hosts {
+			if !matchesDedicatedHostSelector(host, nodeClass.Spec.DedicatedVmHostSelectorTerms) {
+				continue
+			}
+			dedicatedHostCapacityAvailable.With(prometheus.Labels{
+				dedicatedHostLabel: lo.FromPtr(host.Id),
+			}).Set(float64(lo.FromPtr(host.RemainingOcpus)))
+			for _, shapeOption := range shapesResp.Items {
+				shapeName := lo.FromPtr(shapeOption.InstanceShapeName)
+				hostsByShape[shapeName] = append(hostsByShape[shapeName], internalmodel.DedicatedVmHost{
+					Id:   lo.FromPtr(host.Id),
+					Zone: ad,
+					Fd:   lo.FromPtr(host.FaultDomain),
+				})
+			}
+		}
+	}
+
+	p.cache.SetDefault(cacheKey, hostsByShape)
+	return hostsByShape, nil
+}
+
+// dedicatedHostsCacheKey scopes the DVH cache entry to this NodeClass's selector terms, so
+// two NodeClasses pointed at different hosts never read each other's cached result before
+// TTL expiry.
+func dedicatedHostsCacheKey(terms []v1alpha1.DedicatedVmHostSelectorTerm) string {
+	return selectorCacheKey(DedicatedHostsCacheKey, terms)
+}
+
+func matchesDedicatedHostSelector(host core.DedicatedVmHostSummary, terms []v1alpha1.DedicatedVmHostSelectorTerm) bool {
+	for _, term := range terms {
+		if term.Id != "" && term.Id == lo.FromPtr(host.Id) {
+			return true
+		}
+		if term.Name != "" && term.Name == lo.FromPtr(host.DisplayName) {
+			return true
+		}
+		if len(term.Tags) > 0 {
+			match := true
+			for k, v := range term.Tags {
+				if host.FreeformTags[k] != v {
+					match = false
+					break
+				}
+			}
+			if match {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ListCapacityReservations returns the capacity reservations that match the NodeClass's
+// capacityReservationSelectorTerms, keyed by shape name and then by zone. A NodeClass
+// with no selector terms isn't reservation-scoped, so nil is returned and CreateOfferings
+// skips the reserved capacity type entirely.
+func (p *Provider) ListCapacityReservations(ctx context.Context, nodeClass *v1alpha1.OciNodeClass) (map[string]map[string][]internalmodel.CapacityReservation, error) {
+	if len(nodeClass.Spec.CapacityReservationSelectorTerms) == 0 {
+		return nil, nil
+	}
+	cacheKey := reservationsCacheKey(nodeClass.Spec.CapacityReservationSelectorTerms)
+	if cached, ok := p.cache.Get(cacheKey); ok {
+		return cached.(map[string]map[string][]internalmodel.CapacityReservation), nil
+	}
+
+	reservations := make(map[string]map[string][]internalmodel.CapacityReservation)
+	for _, availableDomain := range options.FromContext(ctx).AvailableDomains {
+		summaries := make([]core.ComputeCapacityReservationSummary, 0)
+		nextPage := ""
+		for {
+			resp, err := p.resvClient.ListComputeCapacityReservations(ctx, core.ListComputeCapacityReservationsRequest{
+				CompartmentId:      common.String(options.FromContext(ctx).CompartmentId),
+				AvailabilityDomain: common.String(availableDomain),
+				Page:               common.String(nextPage),
+			})
+			if err != nil {
+				return nil, err
+			}
+			summaries = append(summaries, resp.Items...)
+			if resp.OpcNextPage == nil {
+				break
+			}
+			nextPage = *resp.OpcNextPage
+		}
+		ad := strings.Split(availableDomain, ":")[1]
+		for _, summary := range summaries {
+			if !matchesReservationSelector(summary, nodeClass.Spec.CapacityReservationSelectorTerms) {
+				continue
+			}
+			shapesResp, err := p.resvClient.ListComputeCapacityReservationInstanceShapes(ctx, core.ListComputeCapacityReservationInstanceShapesRequest{
+				CapacityReservationId: summary.Id,
+				CompartmentId:         common.String(options.FromContext(ctx).CompartmentId),
+			})
+			if err != nil {
+				return nil, err
+			}
+			for _, ciShape := range shapesResp.Items {
+				shapeName := lo.FromPtr(ciShape.InstanceShape)
+				if reservations[shapeName] == nil {
+					reservations[shapeName] = make(map[string][]internalmodel.CapacityReservation)
+				}
+				reservations[shapeName][ad] = append(reservations[shapeName][ad], internalmodel.CapacityReservation{
+					Id:             lo.FromPtr(summary.Id),
+					RemainingCount: lo.FromPtr(ciShape.InstanceReservedCount) - lo.FromPtr(ciShape.InstanceUsedCount),
+				})
+			}
+		}
+	}
+
+	p.cache.SetDefault(cacheKey, reservations)
+	return reservations, nil
+}
+
+// reservationsCacheKey scopes the reservation cache entry to this NodeClass's selector
+// terms, so two NodeClasses pointed at different reservations never read each other's
+// cached result before TTL expiry.
+func reservationsCacheKey(terms []v1alpha1.CapacityReservationSelectorTerm) string {
+	return selectorCacheKey(ReservationsCacheKey, terms)
+}
+
+// selectorCacheKey derives a cache key from a static prefix and a NodeClass's selector
+// terms, so providers caching selector-scoped results never hand one NodeClass's data to
+// another sharing the same Provider before TTL expiry.
+func selectorCacheKey(prefix string, terms any) string {
+	raw, err := json.Marshal(terms)
+	if err != nil {
+		// Selector terms are plain value types and always marshal; fall back to the
+		// unscoped prefix rather than failing the caller's List call outright.
+		return prefix
+	}
+	sum := sha256.Sum256(raw)
+	return fmt.Sprintf("%s:%x", prefix, sum)
+}
+
+func matchesReservationSelector(summary core.ComputeCapacityReservationSummary, terms []v1alpha1.CapacityReservationSelectorTerm) bool {
+	for _, term := range terms {
+		if term.Id != "" && term.Id == lo.FromPtr(summary.Id) {
+			return true
+		}
+		if term.Name != "" && term.Name == lo.FromPtr(summary.DisplayName) {
+			return true
+		}
+		if len(term.Tags) > 0 {
+			match := true
+			for k, v := range term.Tags {
+				if summary.FreeformTags[k] != v {
+					match = false
+					break
+				}
+			}
+			if match {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// CreateOfferings builds the offerings for a shape, including the reservation-scoped ones
+// below whose requirements carry v1alpha1.LabelCapacityReservationID.
+func (p *Provider) CreateOfferings(ctx context.Context, shape *internalmodel.WrapShape, zones sets.Set[string], reservations map[string]map[string][]internalmodel.CapacityReservation, dedicatedHosts []internalmodel.DedicatedVmHost) []*cloudprovider.Offering {
+	if len(dedicatedHosts) > 0 {
+		// Preemptibles cannot run on a Dedicated VM Host, and reservations are orthogonal
+		// to DVH placement, so a DVH-scoped shape only ever offers on-demand, per host.
+		return p.dedicatedHostOfferings(shape, dedicatedHosts)
+	}
+
 	var offerings []*cloudprovider.Offering
 
 	for zone := range zones {
 		for _, capacityType := range supportInstanceTypes {
+			if capacityType == v1alpha1.CapacityTypeReserved {
+				offerings = append(offerings, p.reservedOfferings(shape, zone, reservations[*shape.Shape.Shape][zone])...)
+				continue
+			}
 			// exclude any offerings that have recently seen an insufficient capacity error
 			isUnavailable := p.unavailableOfferings.IsUnavailable(*shape.Shape.Shape, zone, capacityType)
 
-			price := float64(p.priceProvider.Price(shape))
+			onDemandPrice := float64(p.priceProvider.Price(shape))
+			price := onDemandPrice
 			if capacityType == v1alpha1.CapacityTypePreemptible {
 				// Filters shapes that preemptible is supported
 				if supportPreemptible(ctx, *shape.Shape.Shape) {
-					// Preemptible is 50% OFF of on-demand price
-					price = price * 0.5
+					// Ask the pricing provider for the real per-shape, per-zone discount;
+					// it falls back to the 50% heuristic internally if the Pricing API
+					// lookup fails, so price is never left unset here.
+					price = p.priceProvider.PreemptiblePrice(shape, zone)
+					if onDemandPrice > 0 {
+						preemptibleDiscountRatio.With(prometheus.Labels{
+							instanceTypeLabel: *shape.Shape.Shape,
+							zoneLabel:         zone,
+						}).Set(price / onDemandPrice)
+					}
 				} else {
 					// Non-VM shapes aren't supported as preemptible
 					isUnavailable = true
@@ -118,6 +426,54 @@ func (p *Provider) CreateOfferings(ctx context.Context, shape *internalmodel.Wra
 	return offerings
 }
 
+// reservedOfferings emits one offering per capacity reservation available to this shape
+// in zone, so the scheduler can bin-pack onto a specific reservation via its OCID rather
+// than treating all reserved capacity in a zone as fungible.
+func (p *Provider) reservedOfferings(shape *internalmodel.WrapShape, zone string, reservations []internalmodel.CapacityReservation) []*cloudprovider.Offering {
+	offerings := make([]*cloudprovider.Offering, 0, len(reservations))
+	for _, reservation := range reservations {
+		isUnavailable := reservation.RemainingCount <= 0 || p.unavailableOfferings.IsUnavailable(*shape.Shape.Shape, zone, v1alpha1.CapacityTypeReserved)
+		offerings = append(offerings, &cloudprovider.Offering{
+			Requirements: scheduling.NewRequirements(
+				scheduling.NewRequirement(v1.CapacityTypeLabelKey, corev1.NodeSelectorOpIn, v1alpha1.CapacityTypeReserved),
+				scheduling.NewRequirement(corev1.LabelTopologyZone, corev1.NodeSelectorOpIn, zone),
+				scheduling.NewRequirement(v1alpha1.LabelCapacityReservationID, corev1.NodeSelectorOpIn, reservation.Id),
+			),
+			// The reservation is already paid for regardless of use, so there's no marginal
+			// price for the scheduler to bin-pack against.
+			Price:     0,
+			Available: !isUnavailable,
+		})
+		instanceTypeOfferingAvailable.With(prometheus.Labels{
+			instanceTypeLabel: *shape.Shape.Shape,
+			capacityTypeLabel: v1alpha1.CapacityTypeReserved,
+			zoneLabel:         zone,
+		}).Set(float64(lo.Ternary(!isUnavailable, 1, 0)))
+	}
+	return offerings
+}
+
+// dedicatedHostOfferings produces one on-demand offering per Dedicated VM Host able to
+// run shape, using the host's AD and fault domain as the offering's zone requirements.
+func (p *Provider) dedicatedHostOfferings(shape *internalmodel.WrapShape, hosts []internalmodel.DedicatedVmHost) []*cloudprovider.Offering {
+	offerings := make([]*cloudprovider.Offering, 0, len(hosts))
+	price := float64(p.priceProvider.Price(shape))
+	for _, host := range hosts {
+		isUnavailable := p.unavailableOfferings.IsUnavailable(*shape.Shape.Shape, host.Zone, v1.CapacityTypeOnDemand)
+		offerings = append(offerings, &cloudprovider.Offering{
+			Requirements: scheduling.NewRequirements(
+				scheduling.NewRequirement(v1.CapacityTypeLabelKey, corev1.NodeSelectorOpIn, v1.CapacityTypeOnDemand),
+				scheduling.NewRequirement(corev1.LabelTopologyZone, corev1.NodeSelectorOpIn, host.Zone),
+				scheduling.NewRequirement(v1alpha1.LabelFaultDomain, corev1.NodeSelectorOpIn, host.Fd),
+				scheduling.NewRequirement(v1alpha1.LabelDedicatedVmHostID, corev1.NodeSelectorOpIn, host.Id),
+			),
+			Price:     price,
+			Available: !isUnavailable,
+		})
+	}
+	return offerings
+}
+
 func supportPreemptible(ctx context.Context, shapeName string) bool {
 	preemptibleList := strings.Split(options.FromContext(ctx).PreemptibleShapes, ",")
 	excludeList := strings.Split(options.FromContext(ctx).PreemptibleExcludeShapes, ",")
@@ -185,6 +541,15 @@ func (p *Provider) ListInstanceType(ctx context.Context) (map[string]*internalmo
 	}
 
 	p.cache.SetDefault(InstanceTypesCacheKey, wrapShapes)
+	if p.cacheStale.CompareAndSwap(true, false) {
+		instanceTypeCacheStale.Set(0)
+		log.FromContext(ctx).Info("instance type cache refreshed from live API; no longer stale")
+	}
+	if p.snapshotStore != nil {
+		if err := p.snapshotStore.Save(p.region, wrapShapes); err != nil {
+			log.FromContext(ctx).Error(err, "failed to persist instance type snapshot")
+		}
+	}
 	return wrapShapes, nil
 }
 
@@ -202,6 +567,10 @@ func toWrapShape(ctx context.Context, shapes []core.Shape, ad string) []*interna
 				AvailableDomains:      []string{ad},
 				CalMaxVnic:            int64(*shape.MaxVnicAttachments),
 				CalMaxBandwidthInGbps: int64(*shape.NetworkingBandwidthInGbps),
+				GpuCount:              int64(lo.FromPtr(shape.Gpus)),
+				GpuModel:              lo.FromPtr(shape.GpuDescription),
+				LocalNvmeGBs:          localNvmeGBs(shape),
+				RdmaEligible:          isRdmaEligible(*shape.Shape),
 			})
 		}
 	}
@@ -262,8 +631,85 @@ func splitFlexCpuMem(ctx context.Context, shape core.Shape, ad string) []*intern
 				AvailableDomains:      []string{ad},
 				CalMaxVnic:            calMaxVnic,
 				CalMaxBandwidthInGbps: calMaxBandwidth,
+				GpuCount:              int64(lo.FromPtr(shape.Gpus)),
+				GpuModel:              lo.FromPtr(shape.GpuDescription),
+				LocalNvmeGBs:          localNvmeGBs(shape),
+				RdmaEligible:          isRdmaEligible(shapeName),
 			})
 		}
 	}
 	return wrapShapes
 }
+
+// localNvmeGBs sums the shape's local NVMe disks into a single usable capacity figure.
+func localNvmeGBs(shape core.Shape) int64 {
+	if shape.LocalDisksTotalSizeInGBs == nil {
+		return 0
+	}
+	return int64(*shape.LocalDisksTotalSizeInGBs)
+}
+
+// isRdmaEligible reports whether the shape participates in the OCI cluster network /
+// RDMA fabric, which today is limited to GPU and HPC bare metal shapes.
+func isRdmaEligible(shapeName string) bool {
+	return strings.HasPrefix(shapeName, "BM.GPU") ||
+		strings.HasPrefix(shapeName, "BM.HPC") ||
+		strings.HasPrefix(shapeName, "BM.Optimized3")
+}
+
+const (
+	LabelGPUCount    = "karpenter.k8s.oracle/gpu-count"
+	LabelGPUModel    = "karpenter.k8s.oracle/gpu-model"
+	LabelLocalNvmeGB = "karpenter.k8s.oracle/local-nvme-gb"
+	LabelRDMA        = "karpenter.k8s.oracle/rdma"
+)
+
+// ResourceNvidiaGPU is the extended resource name the NVIDIA device plugin advertises;
+// karpenter-oci doesn't run the plugin itself, it only needs to advertise capacity that
+// matches what the plugin will later report once the node joins.
+const ResourceNvidiaGPU = corev1.ResourceName("nvidia.com/gpu")
+
+// gpuDaemonOverheadPerGPU approximates the resources the NVIDIA driver/device-plugin
+// daemonset reserves per GPU, mirroring the per-accelerator overhead reservation other
+// karpenter cloud providers apply for their GPU device plugins.
+var gpuDaemonOverheadPerGPU = corev1.ResourceList{
+	corev1.ResourceCPU:    resource.MustParse("100m"),
+	corev1.ResourceMemory: resource.MustParse("256Mi"),
+}
+
+// shapeLabels returns the well-known GPU/local-NVMe/RDMA scheduling labels for shape, on
+// top of the CPU/memory/network ones NewInstanceType already derives from WrapShape.
+func shapeLabels(shape *internalmodel.WrapShape) map[string]string {
+	labels := make(map[string]string)
+	if shape.GpuCount > 0 {
+		labels[LabelGPUCount] = strconv.FormatInt(shape.GpuCount, 10)
+		if shape.GpuModel != "" {
+			labels[LabelGPUModel] = shape.GpuModel
+		}
+	}
+	if shape.LocalNvmeGBs > 0 {
+		labels[LabelLocalNvmeGB] = strconv.FormatInt(shape.LocalNvmeGBs, 10)
+	}
+	if shape.RdmaEligible {
+		labels[LabelRDMA] = "true"
+	}
+	return labels
+}
+
+// shapeExtendedResources returns the nvidia.com/gpu capacity shape should advertise, and
+// the overhead to reserve for the GPU driver/device-plugin daemonset, for NewInstanceType
+// to fold into the InstanceType's Capacity and Overhead resource lists. Returns nil, nil
+// for non-GPU shapes.
+func shapeExtendedResources(shape *internalmodel.WrapShape) (capacity corev1.ResourceList, overhead corev1.ResourceList) {
+	if shape.GpuCount <= 0 {
+		return nil, nil
+	}
+	capacity = corev1.ResourceList{
+		ResourceNvidiaGPU: *resource.NewQuantity(shape.GpuCount, resource.DecimalSI),
+	}
+	overhead = make(corev1.ResourceList, len(gpuDaemonOverheadPerGPU))
+	for name, qty := range gpuDaemonOverheadPerGPU {
+		overhead[name] = *resource.NewMilliQuantity(qty.MilliValue()*shape.GpuCount, qty.Format)
+	}
+	return capacity, overhead
+}
@@ -0,0 +1,44 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancetype
+
+import (
+	"context"
+	"testing"
+
+	"github.com/zoom/karpenter-oci/pkg/operator/options"
+)
+
+func TestSupportPreemptible(t *testing.T) {
+	ctx := options.ToContext(context.Background(), &options.Options{
+		PreemptibleShapes:        "VM.Standard.E4.Flex,VM.Standard3.Flex",
+		PreemptibleExcludeShapes: "VM.Standard3.Flex.HighIO",
+	})
+
+	tests := []struct {
+		shapeName string
+		want      bool
+	}{
+		{"VM.Standard.E4.Flex", true},
+		{"VM.Standard3.Flex", true},
+		{"VM.Standard3.Flex.HighIO", false},
+		{"BM.Standard.E4.128", false},
+	}
+	for _, tt := range tests {
+		if got := supportPreemptible(ctx, tt.shapeName); got != tt.want {
+			t.Errorf("supportPreemptible(%q) = %v, want %v", tt.shapeName, got, tt.want)
+		}
+	}
+}
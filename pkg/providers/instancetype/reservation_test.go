@@ -0,0 +1,70 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancetype
+
+import (
+	"testing"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/zoom/karpenter-oci/pkg/apis/v1alpha1"
+)
+
+func TestMatchesReservationSelector(t *testing.T) {
+	summary := core.ComputeCapacityReservationSummary{
+		Id:           common.String("ocid1.capacityreservation.oc1..aaa"),
+		DisplayName:  common.String("team-a-reservation"),
+		FreeformTags: map[string]string{"team": "a"},
+	}
+
+	tests := []struct {
+		name  string
+		terms []v1alpha1.CapacityReservationSelectorTerm
+		want  bool
+	}{
+		{"matches by id", []v1alpha1.CapacityReservationSelectorTerm{{Id: "ocid1.capacityreservation.oc1..aaa"}}, true},
+		{"matches by name", []v1alpha1.CapacityReservationSelectorTerm{{Name: "team-a-reservation"}}, true},
+		{"matches by tag", []v1alpha1.CapacityReservationSelectorTerm{{Tags: map[string]string{"team": "a"}}}, true},
+		{"no match on different id", []v1alpha1.CapacityReservationSelectorTerm{{Id: "ocid1.capacityreservation.oc1..bbb"}}, false},
+		{"no match on different tag value", []v1alpha1.CapacityReservationSelectorTerm{{Tags: map[string]string{"team": "b"}}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchesReservationSelector(summary, tt.terms); got != tt.want {
+				t.Errorf("matchesReservationSelector() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestReservationsCacheKeyScopedBySelector guards against the bug where two NodeClasses
+// with different capacityReservationSelectorTerms shared one Provider's cache entry and
+// could read each other's reservations.
+func TestReservationsCacheKeyScopedBySelector(t *testing.T) {
+	a := []v1alpha1.CapacityReservationSelectorTerm{{Id: "ocid1.capacityreservation.oc1..aaa"}}
+	b := []v1alpha1.CapacityReservationSelectorTerm{{Id: "ocid1.capacityreservation.oc1..bbb"}}
+
+	keyA := reservationsCacheKey(a)
+	keyB := reservationsCacheKey(b)
+	if keyA == keyB {
+		t.Fatal("different selector terms must not produce the same cache key")
+	}
+	if keyA != reservationsCacheKey(a) {
+		t.Fatal("the same selector terms must produce a stable cache key")
+	}
+	if keyA == ReservationsCacheKey {
+		t.Fatal("cache key must not collapse to the unscoped prefix when terms are present")
+	}
+}
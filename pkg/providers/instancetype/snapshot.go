@@ -0,0 +1,127 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancetype
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/zoom/karpenter-oci/pkg/providers/internalmodel"
+)
+
+// snapshotSchemaVersion must be bumped whenever WrapShape's on-disk shape changes, so a
+// snapshot written by an older binary is rejected instead of partially deserialized.
+const snapshotSchemaVersion = 1
+
+// Snapshot is the result of a successful SnapshotStore.Load.
+type Snapshot struct {
+	Shapes map[string]*internalmodel.WrapShape
+	// Stale marks the snapshot as loaded from disk rather than a live API call; the first
+	// successful ListInstanceType refresh overwrites it with live data.
+	Stale bool
+}
+
+// SnapshotStore persists the instance type cache to a durable location so a controller
+// restart can warm-start from the last known-good listing instead of re-hitting
+// ListShapes from a cold cache, which OCI's compute control plane throttles aggressively
+// per tenancy.
+type SnapshotStore interface {
+	// Load returns the last persisted snapshot for region, or ok=false if none exists or
+	// it fails its schema/checksum check.
+	Load(region string) (snapshot Snapshot, ok bool)
+	// Save persists shapes for region, overwriting any previous snapshot.
+	Save(region string, shapes map[string]*internalmodel.WrapShape) error
+}
+
+type fileSnapshot struct {
+	SchemaVersion int                                 `json:"schemaVersion"`
+	Region        string                              `json:"region"`
+	Checksum      string                              `json:"checksum"`
+	Shapes        map[string]*internalmodel.WrapShape `json:"shapes"`
+}
+
+// FileSnapshotStore is the default SnapshotStore, backed by one JSON file per region
+// under dir.
+type FileSnapshotStore struct {
+	dir string
+}
+
+func NewFileSnapshotStore(dir string) *FileSnapshotStore {
+	return &FileSnapshotStore{dir: dir}
+}
+
+// NewSnapshotStoreFromDir builds the SnapshotStore NewProvider should warm-start from,
+// given the configured --instance-type-cache-dir. An empty dir (the flag's default)
+// disables disk persistence, matching NewProvider's documented nil-snapshotStore behavior.
+func NewSnapshotStoreFromDir(dir string) SnapshotStore {
+	if dir == "" {
+		return nil
+	}
+	return NewFileSnapshotStore(dir)
+}
+
+func (f *FileSnapshotStore) path(region string) string {
+	return filepath.Join(f.dir, fmt.Sprintf("instance-types-%s.json", region))
+}
+
+func (f *FileSnapshotStore) Load(region string) (Snapshot, bool) {
+	raw, err := os.ReadFile(f.path(region))
+	if err != nil {
+		return Snapshot{}, false
+	}
+	var snap fileSnapshot
+	if err := json.Unmarshal(raw, &snap); err != nil {
+		return Snapshot{}, false
+	}
+	if snap.SchemaVersion != snapshotSchemaVersion || snap.Region != region {
+		return Snapshot{}, false
+	}
+	if snap.Checksum != snapshotChecksum(snap.Shapes) {
+		return Snapshot{}, false
+	}
+	return Snapshot{Shapes: snap.Shapes, Stale: true}, true
+}
+
+func (f *FileSnapshotStore) Save(region string, shapes map[string]*internalmodel.WrapShape) error {
+	if err := os.MkdirAll(f.dir, 0o755); err != nil {
+		return err
+	}
+	snap := fileSnapshot{
+		SchemaVersion: snapshotSchemaVersion,
+		Region:        region,
+		Shapes:        shapes,
+		Checksum:      snapshotChecksum(shapes),
+	}
+	raw, err := json.Marshal(snap)
+	if err != nil {
+		return err
+	}
+	// Write to a temp file and rename so a crash mid-write can't leave a truncated,
+	// unloadable snapshot behind.
+	tmp := f.path(region) + ".tmp"
+	if err := os.WriteFile(tmp, raw, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, f.path(region))
+}
+
+func snapshotChecksum(shapes map[string]*internalmodel.WrapShape) string {
+	raw, _ := json.Marshal(shapes)
+	sum := sha256.Sum256(raw)
+	return fmt.Sprintf("%x", sum)
+}
@@ -0,0 +1,160 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancetype
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/zoom/karpenter-oci/pkg/providers/internalmodel"
+)
+
+func testShapes() map[string]*internalmodel.WrapShape {
+	return map[string]*internalmodel.WrapShape{
+		"VM.Standard.E4.Flex-2-16": {
+			Shape:       core.Shape{Shape: common.String("VM.Standard.E4.Flex")},
+			CalcCpu:     2,
+			CalMemInGBs: 16,
+		},
+	}
+}
+
+func TestFileSnapshotStoreRoundTrip(t *testing.T) {
+	store := NewFileSnapshotStore(t.TempDir())
+	shapes := testShapes()
+
+	if err := store.Save("us-ashburn-1", shapes); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+
+	snap, ok := store.Load("us-ashburn-1")
+	if !ok {
+		t.Fatal("Load returned ok=false for a snapshot that was just saved")
+	}
+	if !snap.Stale {
+		t.Error("a freshly loaded snapshot should be marked Stale until a live refresh completes")
+	}
+	if len(snap.Shapes) != len(shapes) {
+		t.Errorf("got %d shapes back, want %d", len(snap.Shapes), len(shapes))
+	}
+}
+
+func TestNewSnapshotStoreFromDir(t *testing.T) {
+	if store := NewSnapshotStoreFromDir(""); store != nil {
+		t.Errorf("NewSnapshotStoreFromDir(\"\") = %v, want nil (disk persistence disabled)", store)
+	}
+
+	dir := t.TempDir()
+	store := NewSnapshotStoreFromDir(dir)
+	if store == nil {
+		t.Fatal("NewSnapshotStoreFromDir(dir) = nil, want a *FileSnapshotStore")
+	}
+	shapes := testShapes()
+	if err := store.Save("us-ashburn-1", shapes); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+	if _, ok := store.Load("us-ashburn-1"); !ok {
+		t.Error("Load returned ok=false for a snapshot that was just saved")
+	}
+}
+
+func TestFileSnapshotStoreLoadMissing(t *testing.T) {
+	store := NewFileSnapshotStore(t.TempDir())
+	if _, ok := store.Load("us-ashburn-1"); ok {
+		t.Error("Load should return ok=false when no snapshot has been saved")
+	}
+}
+
+func TestFileSnapshotStoreLoadRegionMismatch(t *testing.T) {
+	store := NewFileSnapshotStore(t.TempDir())
+	if err := store.Save("us-ashburn-1", testShapes()); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+	if _, ok := store.Load("us-phoenix-1"); ok {
+		t.Error("Load should reject a snapshot saved under a different region")
+	}
+}
+
+func TestFileSnapshotStoreLoadRejectsCorruptedChecksum(t *testing.T) {
+	dir := t.TempDir()
+	store := NewFileSnapshotStore(dir)
+	if err := store.Save("us-ashburn-1", testShapes()); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+
+	path := store.path("us-ashburn-1")
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read snapshot file: %v", err)
+	}
+	// Flip a byte in the middle of the file so the checksum no longer matches, simulating
+	// partial on-disk corruption.
+	corrupted := []byte(string(raw))
+	corrupted[len(corrupted)/2] ^= 0xFF
+	if err := os.WriteFile(path, corrupted, 0o644); err != nil {
+		t.Fatalf("failed to write corrupted snapshot: %v", err)
+	}
+
+	if _, ok := store.Load("us-ashburn-1"); ok {
+		t.Error("Load should reject a snapshot whose checksum no longer matches its contents")
+	}
+}
+
+// TestFileSnapshotStoreScopedByDir guards the constructor argument a deployment's
+// cache-dir configuration ultimately controls: two stores pointed at different
+// directories must never see each other's snapshots.
+func TestFileSnapshotStoreScopedByDir(t *testing.T) {
+	storeA := NewFileSnapshotStore(t.TempDir())
+	storeB := NewFileSnapshotStore(t.TempDir())
+
+	if err := storeA.Save("us-ashburn-1", testShapes()); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+	if _, ok := storeB.Load("us-ashburn-1"); ok {
+		t.Error("a store rooted at a different dir should not see another store's snapshot")
+	}
+}
+
+func TestFileSnapshotStoreLoadRejectsSchemaVersionMismatch(t *testing.T) {
+	dir := t.TempDir()
+	store := NewFileSnapshotStore(dir)
+	shapes := testShapes()
+	if err := store.Save("us-ashburn-1", shapes); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+
+	// Simulate an older/newer binary's incompatible on-disk schema by writing a snapshot
+	// with a different SchemaVersion but a checksum still computed the same way.
+	other := fileSnapshot{
+		SchemaVersion: snapshotSchemaVersion + 1,
+		Region:        "us-ashburn-1",
+		Shapes:        shapes,
+		Checksum:      snapshotChecksum(shapes),
+	}
+	raw, err := json.Marshal(other)
+	if err != nil {
+		t.Fatalf("failed to marshal snapshot: %v", err)
+	}
+	if err := os.WriteFile(store.path("us-ashburn-1"), raw, 0o644); err != nil {
+		t.Fatalf("failed to write snapshot: %v", err)
+	}
+
+	if _, ok := store.Load("us-ashburn-1"); ok {
+		t.Error("Load should reject a snapshot with a newer/older schema version")
+	}
+}
@@ -0,0 +1,62 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancetype
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	v1 "sigs.k8s.io/karpenter/pkg/apis/v1"
+	"sigs.k8s.io/karpenter/pkg/cloudprovider"
+	"sigs.k8s.io/karpenter/pkg/scheduling"
+
+	"github.com/zoom/karpenter-oci/pkg/apis/v1alpha1"
+	"github.com/zoom/karpenter-oci/pkg/providers/internalmodel"
+)
+
+// NewInstanceType builds the cloudprovider.InstanceType the scheduler binds NodeClaims
+// against: its requirements (instance type, region, zone, capacity type, and the shape's
+// GPU/RDMA/local-NVMe labels), its offerings, and the capacity/overhead it advertises.
+func NewInstanceType(ctx context.Context, shape *internalmodel.WrapShape, nodeClass *v1alpha1.OciNodeClass, region string, zones []string, offerings []*cloudprovider.Offering) *cloudprovider.InstanceType {
+	requirements := scheduling.NewRequirements(
+		scheduling.NewRequirement(corev1.LabelInstanceTypeStable, corev1.NodeSelectorOpIn, *shape.Shape.Shape),
+		scheduling.NewRequirement(corev1.LabelTopologyRegion, corev1.NodeSelectorOpIn, region),
+		scheduling.NewRequirement(corev1.LabelTopologyZone, corev1.NodeSelectorOpIn, zones...),
+		scheduling.NewRequirement(v1.CapacityTypeLabelKey, corev1.NodeSelectorOpIn, supportInstanceTypes...),
+	)
+	for label, value := range shapeLabels(shape) {
+		requirements.Add(scheduling.NewRequirement(label, corev1.NodeSelectorOpIn, value))
+	}
+
+	capacity := corev1.ResourceList{
+		corev1.ResourceCPU:    *resource.NewQuantity(shape.CalcCpu, resource.DecimalSI),
+		corev1.ResourceMemory: *resource.NewQuantity(shape.CalMemInGBs*1024*1024*1024, resource.BinarySI),
+	}
+	extCapacity, overhead := shapeExtendedResources(shape)
+	for name, qty := range extCapacity {
+		capacity[name] = qty
+	}
+
+	return &cloudprovider.InstanceType{
+		Name:         *shape.Shape.Shape,
+		Requirements: requirements,
+		Offerings:    offerings,
+		Capacity:     capacity,
+		Overhead: &cloudprovider.InstanceTypeOverhead{
+			SystemReserved: overhead,
+		},
+	}
+}
@@ -0,0 +1,72 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancetype
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/zoom/karpenter-oci/pkg/providers/internalmodel"
+)
+
+func TestNewInstanceTypeEmitsGPULabelsAndCapacity(t *testing.T) {
+	shape := &internalmodel.WrapShape{
+		Shape:       core.Shape{Shape: common.String("BM.GPU4.8")},
+		CalcCpu:     64,
+		CalMemInGBs: 512,
+		GpuCount:    8,
+		GpuModel:    "NVIDIA A100",
+	}
+
+	it := NewInstanceType(context.Background(), shape, nil, "us-ashburn-1", []string{"us-ashburn-1-ad-1"}, nil)
+
+	if it.Name != "BM.GPU4.8" {
+		t.Errorf("Name = %q, want %q", it.Name, "BM.GPU4.8")
+	}
+	if got := it.Requirements.Get(LabelGPUCount).Any(); got != "8" {
+		t.Errorf("Requirements[%s] = %q, want %q", LabelGPUCount, got, "8")
+	}
+	gpuQty, ok := it.Capacity[ResourceNvidiaGPU]
+	if !ok {
+		t.Fatal("Capacity is missing the nvidia.com/gpu resource")
+	}
+	if gpuQty.Value() != 8 {
+		t.Errorf("Capacity[nvidia.com/gpu] = %d, want 8", gpuQty.Value())
+	}
+	for name := range gpuDaemonOverheadPerGPU {
+		if _, ok := it.Overhead.SystemReserved[name]; !ok {
+			t.Errorf("Overhead.SystemReserved is missing the per-GPU daemonset %s overhead", name)
+		}
+	}
+}
+
+func TestNewInstanceTypeNonGPUShapeHasNoGPUCapacity(t *testing.T) {
+	shape := &internalmodel.WrapShape{
+		Shape:       core.Shape{Shape: common.String("VM.Standard.E4.Flex")},
+		CalcCpu:     4,
+		CalMemInGBs: 16,
+	}
+
+	it := NewInstanceType(context.Background(), shape, nil, "us-ashburn-1", []string{"us-ashburn-1-ad-1"}, nil)
+
+	if _, ok := it.Capacity[ResourceNvidiaGPU]; ok {
+		t.Error("Capacity should not contain nvidia.com/gpu for a non-GPU shape")
+	}
+	if it.Requirements.Has(LabelGPUCount) {
+		t.Error("Requirements should not contain a GPU count label for a non-GPU shape")
+	}
+}
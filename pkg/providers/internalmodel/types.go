@@ -0,0 +1,50 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package internalmodel
+
+import "github.com/oracle/oci-go-sdk/v65/core"
+
+// WrapShape wraps an OCI core.Shape with the provider's derived, per-flex-configuration
+// sizing, so one flexible shape can expand into multiple schedulable instance types.
+type WrapShape struct {
+	Shape                 core.Shape
+	CalcCpu               int64
+	CalMemInGBs           int64
+	AvailableDomains      []string
+	CalMaxVnic            int64
+	CalMaxBandwidthInGbps int64
+	// GpuCount and GpuModel are zero/empty for non-GPU shapes.
+	GpuCount int64
+	GpuModel string
+	// LocalNvmeGBs is the shape's total local NVMe disk capacity, 0 if it has none.
+	LocalNvmeGBs int64
+	// RdmaEligible reports whether the shape participates in the OCI cluster network.
+	RdmaEligible bool
+}
+
+// CapacityReservation is a single OCI Compute Capacity Reservation matched by a
+// NodeClass's capacityReservationSelectorTerms, scoped to one shape and zone.
+type CapacityReservation struct {
+	Id             string
+	RemainingCount int
+}
+
+// DedicatedVmHost is a single OCI Dedicated VM Host matched by a NodeClass's
+// dedicatedVmHostSelectorTerms, scoped to one shape.
+type DedicatedVmHost struct {
+	Id   string
+	Zone string
+	Fd   string
+}
@@ -0,0 +1,26 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pricing
+
+import "github.com/zoom/karpenter-oci/pkg/providers/internalmodel"
+
+// Provider estimates the on-demand and preemptible price of a shape.
+type Provider interface {
+	Price(shape *internalmodel.WrapShape) float32
+	// PreemptiblePrice returns shape's preemptible price in zone. Implementations should
+	// fall back to a flat discount off Price if a real per-shape, per-zone preemptible
+	// price can't be obtained.
+	PreemptiblePrice(shape *internalmodel.WrapShape, zone string) float64
+}
@@ -0,0 +1,23 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import "strings"
+
+// IsA1FlexShape reports whether shapeName is an Ampere A1 flexible shape, whose
+// OCPU-to-vCPU ratio is 1:1 rather than the 2:1 ratio x86 flexible shapes use.
+func IsA1FlexShape(shapeName string) bool {
+	return strings.HasPrefix(shapeName, "VM.Standard.A1.Flex") || strings.HasPrefix(shapeName, "BM.Standard.A1.Flex")
+}